@@ -4,19 +4,30 @@ import (
 	"context"
 	"math/big"
 
-	"github.com/patrickchagastavares/conta-corrent/model"
+	"github.com/patrickchagastavares/conta-corrent/app/transaction"
+	"github.com/patrickchagastavares/conta-corrent/authctx"
+	"github.com/patrickchagastavares/conta-corrent/internal/models"
 	"github.com/patrickchagastavares/conta-corrent/store"
 	"github.com/patrickchagastavares/conta-corrent/utils/logger"
 	"github.com/patrickchagastavares/conta-corrent/utils/password"
 )
 
 type App interface {
-	List(ctx context.Context) ([]*model.Account, error)
-	GetBalanceByID(ctx context.Context, id int) (*model.Account, error)
-	GetByCpf(ctx context.Context, cpf string) (*model.Account, error)
-	GetByID(ctx context.Context, id int) (*model.Account, error)
-	Create(ctx context.Context, account *model.Account) error
-	UpdateBalance(ctx context.Context, account *model.Account) error
+	List(ctx context.Context) ([]*models.Account, error)
+	GetBalanceByID(ctx context.Context, id int) (*models.Account, error)
+	// GetByCpf busca a conta pelo documento do titular (cpf ou cnpj, apenas dígitos)
+	GetByCpf(ctx context.Context, cpf string) (*models.Account, error)
+	GetByID(ctx context.Context, id int) (*models.Account, error)
+	Create(ctx context.Context, account *models.Account) error
+	UpdateBalance(ctx context.Context, account *models.Account) error
+	// Authenticate confere cpf/secret e, em caso de sucesso, re-hasheia o
+	// segredo transparentemente se o hash armazenado usa um algoritmo ou
+	// parâmetros mais fracos que o default atual.
+	Authenticate(ctx context.Context, cpf, secret string) (*models.Account, error)
+	// Transfer move amount da conta fromID para a conta toID, registrando a
+	// transferência no ledger. Chamadas repetidas com a mesma idempotencyKey
+	// retornam a transação original, sem debitar a conta novamente.
+	Transfer(ctx context.Context, fromID, toID int, amount *big.Int, idempotencyKey string) (*models.Transaction, error)
 }
 
 type appImpl struct {
@@ -32,7 +43,7 @@ func NewApp(stores *store.Container, password password.Password) App {
 	}
 }
 
-func (a *appImpl) List(ctx context.Context) ([]*model.Account, error) {
+func (a *appImpl) List(ctx context.Context) ([]*models.Account, error) {
 	accounts, err := a.stores.Account.List(ctx)
 	if err != nil {
 		return nil, errAccountList
@@ -41,11 +52,15 @@ func (a *appImpl) List(ctx context.Context) ([]*model.Account, error) {
 	return accounts, nil
 }
 
-func (a *appImpl) GetBalanceByID(ctx context.Context, id int) (*model.Account, error) {
+func (a *appImpl) GetBalanceByID(ctx context.Context, id int) (*models.Account, error) {
 	if id <= 0 {
 		return nil, errAccountID
 	}
 
+	if callerID, ok := authctx.AccountID(ctx); !ok || callerID != id {
+		return nil, errAccountForbidden
+	}
+
 	account, err := a.stores.Account.GetBalanceByID(ctx, id)
 	if err != nil {
 		return nil, errAccountBalanceByID
@@ -54,13 +69,13 @@ func (a *appImpl) GetBalanceByID(ctx context.Context, id int) (*model.Account, e
 	return account, nil
 }
 
-func (a *appImpl) Create(ctx context.Context, account *model.Account) error {
+func (a *appImpl) Create(ctx context.Context, account *models.Account) error {
 
 	if err := account.Validate(); err != nil {
 		return err
 	}
 
-	exists, err := a.stores.Account.CpfExists(ctx, account.CPF)
+	exists, err := a.stores.Account.CpfExists(ctx, account.Document.Digits)
 	if err != nil {
 		return errAccountCreate
 	}
@@ -69,8 +84,12 @@ func (a *appImpl) Create(ctx context.Context, account *model.Account) error {
 		return errAccountCpfExists
 	}
 
-	account.SecretSalt = a.password.Salt()
-	account.SecretHash = a.password.Encode(account.Secret, account.SecretSalt)
+	secretHash, err := a.password.Hash(account.Secret)
+	if err != nil {
+		logger.ErrorContext(ctx, err)
+		return errAccountCreate
+	}
+	account.SecretHash = secretHash
 
 	if err := a.stores.Account.Create(ctx, account); err != nil {
 		logger.ErrorContext(ctx, err)
@@ -80,7 +99,43 @@ func (a *appImpl) Create(ctx context.Context, account *model.Account) error {
 	return nil
 }
 
-func (a *appImpl) GetByCpf(ctx context.Context, cpf string) (*model.Account, error) {
+func (a *appImpl) Authenticate(ctx context.Context, cpf, secret string) (*models.Account, error) {
+	if cpf == "" {
+		return nil, errAccountCpfNotInput
+	}
+
+	if secret == "" {
+		return nil, errSecretRequired
+	}
+
+	// GetByCpf espera apenas dígitos; normaliza aqui para aceitar o mesmo cpf
+	// formatado que a criação de conta aceita (ver Document.UnmarshalJSON)
+	account, err := a.stores.Account.GetByCpf(ctx, models.NewDocument(cpf).Digits)
+	if err != nil {
+		return nil, errAccountCredentialsInvalid
+	}
+
+	ok, err := a.password.Verify(account.SecretHash, secret)
+	if err != nil || !ok {
+		return nil, errAccountCredentialsInvalid
+	}
+
+	if a.password.NeedsUpgrade(account.SecretHash) {
+		newHash, err := a.password.Hash(secret)
+		if err != nil {
+			logger.ErrorContext(ctx, err)
+		} else {
+			account.SecretHash = newHash
+			if err := a.stores.Account.UpdateSecretHash(ctx, account); err != nil {
+				logger.ErrorContext(ctx, err)
+			}
+		}
+	}
+
+	return account, nil
+}
+
+func (a *appImpl) GetByCpf(ctx context.Context, cpf string) (*models.Account, error) {
 
 	if cpf == "" {
 		return nil, errAccountCpfNotInput
@@ -94,7 +149,7 @@ func (a *appImpl) GetByCpf(ctx context.Context, cpf string) (*model.Account, err
 	return account, nil
 }
 
-func (a *appImpl) GetByID(ctx context.Context, id int) (*model.Account, error) {
+func (a *appImpl) GetByID(ctx context.Context, id int) (*models.Account, error) {
 	if id <= 0 {
 		return nil, errAccountID
 	}
@@ -107,12 +162,16 @@ func (a *appImpl) GetByID(ctx context.Context, id int) (*model.Account, error) {
 	return account, nil
 }
 
-func (a *appImpl) UpdateBalance(ctx context.Context, account *model.Account) error {
+func (a *appImpl) UpdateBalance(ctx context.Context, account *models.Account) error {
 
 	if account.ID <= 0 {
 		return errAccountID
 	}
 
+	if callerID, ok := authctx.AccountID(ctx); !ok || callerID != account.ID {
+		return errAccountForbidden
+	}
+
 	if account.Balance.CmpAbs(big.NewInt(0)) < 0 {
 		return errAccountBalance
 	}
@@ -124,3 +183,104 @@ func (a *appImpl) UpdateBalance(ctx context.Context, account *model.Account) err
 
 	return nil
 }
+
+func (a *appImpl) Transfer(ctx context.Context, fromID, toID int, amount *big.Int, idempotencyKey string) (*models.Transaction, error) {
+	if fromID <= 0 || toID <= 0 {
+		return nil, errAccountID
+	}
+
+	if fromID == toID {
+		return nil, errTransferSameAccount
+	}
+
+	if amount == nil || amount.Sign() <= 0 {
+		return nil, errTransferAmountInvalid
+	}
+
+	if callerID, ok := authctx.AccountID(ctx); !ok || callerID != fromID {
+		return nil, errAccountForbidden
+	}
+
+	newTransaction := &models.Transaction{
+		FromAccountID:  fromID,
+		ToAccountID:    toID,
+		Amount:         *amount,
+		Status:         models.TransactionStatusCommitted,
+		IdempotencyKey: idempotencyKey,
+	}
+
+	var existingTransaction *models.Transaction
+
+	err := a.stores.Atomic(ctx, func(ctx context.Context) error {
+		// trava as duas contas sempre na mesma ordem (menor id primeiro) para
+		// evitar deadlock quando duas transferências concorrentes usam as
+		// mesmas contas em sentidos opostos
+		firstID, secondID := fromID, toID
+		if secondID < firstID {
+			firstID, secondID = secondID, firstID
+		}
+
+		first, err := a.stores.Account.GetByIDForUpdate(ctx, firstID)
+		if err != nil {
+			return err
+		}
+
+		second, err := a.stores.Account.GetByIDForUpdate(ctx, secondID)
+		if err != nil {
+			return err
+		}
+
+		from, to := first, second
+		if from.ID != fromID {
+			from, to = second, first
+		}
+
+		// a checagem de idempotencia so pode acontecer depois de travar as
+		// contas: so assim uma segunda chamada concorrente com a mesma chave
+		// fica bloqueada ate a primeira commitar, enxergando a transacao ja
+		// criada em vez de debitar a conta de novo
+		if idempotencyKey != "" {
+			existing, err := a.stores.Transaction.GetByIdempotencyKey(ctx, idempotencyKey)
+			if err != nil {
+				return err
+			}
+			if existing != nil {
+				existingTransaction = existing
+				return nil
+			}
+		}
+
+		if from.Balance.Cmp(amount) < 0 {
+			return errTransferInsufficientFunds
+		}
+
+		from.Balance.Sub(&from.Balance, amount)
+		to.Balance.Add(&to.Balance, amount)
+
+		newEntries := func(transactionID int) []*models.Entry {
+			return transaction.NewEntries(transactionID, fromID, toID, amount)
+		}
+		if err := a.stores.Transaction.Create(ctx, newTransaction, newEntries); err != nil {
+			return err
+		}
+
+		if err := a.stores.Account.UpdateBalance(ctx, from); err != nil {
+			return err
+		}
+
+		return a.stores.Account.UpdateBalance(ctx, to)
+	})
+	if err != nil {
+		if bizErr, ok := err.(*models.Error); ok {
+			return nil, bizErr
+		}
+		logger.ErrorContext(ctx, err)
+		return nil, errTransferCreate
+	}
+
+	if existingTransaction != nil {
+		return existingTransaction, nil
+	}
+
+	return newTransaction, nil
+}
@@ -0,0 +1,225 @@
+package account
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/patrickchagastavares/conta-corrent/authctx"
+	"github.com/patrickchagastavares/conta-corrent/internal/models"
+	"github.com/patrickchagastavares/conta-corrent/store"
+)
+
+// fakeAccountStore e um store.Account em memoria, suficiente para exercitar
+// appImpl.Transfer sem um banco real. Os metodos nao usados por Transfer
+// entram em panic se chamados, para deixar claro que nao fazem parte do que
+// estes testes cobrem.
+type fakeAccountStore struct {
+	accounts map[int]*models.Account
+	lockLog  []int
+}
+
+func newFakeAccountStore(accounts ...*models.Account) *fakeAccountStore {
+	s := &fakeAccountStore{accounts: map[int]*models.Account{}}
+	for _, a := range accounts {
+		s.accounts[a.ID] = a
+	}
+	return s
+}
+
+func (s *fakeAccountStore) List(ctx context.Context) ([]*models.Account, error) {
+	panic("not implemented")
+}
+func (s *fakeAccountStore) GetBalanceByID(ctx context.Context, id int) (*models.Account, error) {
+	panic("not implemented")
+}
+func (s *fakeAccountStore) GetByCpf(ctx context.Context, cpf string) (*models.Account, error) {
+	panic("not implemented")
+}
+func (s *fakeAccountStore) GetByID(ctx context.Context, id int) (*models.Account, error) {
+	panic("not implemented")
+}
+func (s *fakeAccountStore) CpfExists(ctx context.Context, cpf string) (bool, error) {
+	panic("not implemented")
+}
+func (s *fakeAccountStore) Create(ctx context.Context, account *models.Account) error {
+	panic("not implemented")
+}
+func (s *fakeAccountStore) UpdateSecretHash(ctx context.Context, account *models.Account) error {
+	panic("not implemented")
+}
+
+func (s *fakeAccountStore) UpdateBalance(ctx context.Context, account *models.Account) error {
+	stored, ok := s.accounts[account.ID]
+	if !ok {
+		return errors.New("fake: account not found")
+	}
+	stored.Balance = account.Balance
+	return nil
+}
+
+// GetByIDForUpdate registra a ordem em que as contas foram travadas, para que
+// os testes possam conferir o lock ordering determinístico do Transfer.
+func (s *fakeAccountStore) GetByIDForUpdate(ctx context.Context, id int) (*models.Account, error) {
+	s.lockLog = append(s.lockLog, id)
+
+	account, ok := s.accounts[id]
+	if !ok {
+		return nil, errors.New("fake: account not found")
+	}
+	return account, nil
+}
+
+// fakeTransactionStore e um store.Transaction em memoria, usado para
+// exercitar a criação da transferência e a busca por idempotencyKey.
+type fakeTransactionStore struct {
+	nextID       int
+	byID         map[int]*models.Transaction
+	byIdempotent map[string]*models.Transaction
+}
+
+func newFakeTransactionStore() *fakeTransactionStore {
+	return &fakeTransactionStore{
+		byID:         map[int]*models.Transaction{},
+		byIdempotent: map[string]*models.Transaction{},
+	}
+}
+
+func (s *fakeTransactionStore) Create(ctx context.Context, transaction *models.Transaction, newEntries func(transactionID int) []*models.Entry) error {
+	s.nextID++
+	transaction.ID = s.nextID
+
+	// força o uso do ID ja atribuido, replicando o contrato documentado em
+	// store.Transaction.Create
+	_ = newEntries(transaction.ID)
+
+	s.byID[transaction.ID] = transaction
+	if transaction.IdempotencyKey != "" {
+		s.byIdempotent[transaction.IdempotencyKey] = transaction
+	}
+	return nil
+}
+
+func (s *fakeTransactionStore) GetByID(ctx context.Context, id int) (*models.Transaction, error) {
+	transaction, ok := s.byID[id]
+	if !ok {
+		return nil, errors.New("fake: transaction not found")
+	}
+	return transaction, nil
+}
+
+func (s *fakeTransactionStore) GetByIdempotencyKey(ctx context.Context, idempotencyKey string) (*models.Transaction, error) {
+	return s.byIdempotent[idempotencyKey], nil
+}
+
+func (s *fakeTransactionStore) ListByAccount(ctx context.Context, accountID int) ([]*models.Transaction, error) {
+	panic("not implemented")
+}
+
+func (s *fakeTransactionStore) MarkReversed(ctx context.Context, id int) error {
+	panic("not implemented")
+}
+
+type fakeRefreshTokenStore struct{}
+
+func (fakeRefreshTokenStore) Create(ctx context.Context, token *models.RefreshToken) error {
+	panic("not implemented")
+}
+func (fakeRefreshTokenStore) GetByTokenHash(ctx context.Context, tokenHash string) (*models.RefreshToken, error) {
+	panic("not implemented")
+}
+func (fakeRefreshTokenStore) Revoke(ctx context.Context, id int) error { panic("not implemented") }
+
+// fakeAtomic executa fn diretamente: suficiente para estes testes, ja que em
+// todos os cenarios cobertos o erro e devolvido antes de qualquer mutacao.
+type fakeAtomic struct{}
+
+func (fakeAtomic) Atomic(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func newTestApp(accounts ...*models.Account) (App, *fakeAccountStore, *fakeTransactionStore) {
+	accountStore := newFakeAccountStore(accounts...)
+	transactionStore := newFakeTransactionStore()
+	stores := store.NewContainer(accountStore, transactionStore, fakeRefreshTokenStore{}, fakeAtomic{})
+	return NewApp(stores, nil), accountStore, transactionStore
+}
+
+func newAccount(id int, balance int64) *models.Account {
+	return &models.Account{ID: id, Balance: *big.NewInt(balance)}
+}
+
+func TestTransfer_LockOrdering(t *testing.T) {
+	// fromID (5) e maior que toID (2): o lock deve ser adquirido na ordem
+	// determinística (menor id primeiro), nunca na ordem dos parâmetros
+	from := newAccount(5, 100)
+	to := newAccount(2, 0)
+
+	app, accountStore, _ := newTestApp(from, to)
+	ctx := authctx.WithAccountID(context.Background(), 5)
+
+	if _, err := app.Transfer(ctx, 5, 2, big.NewInt(30), ""); err != nil {
+		t.Fatalf("Transfer() error = %v, want nil", err)
+	}
+
+	if want := []int{2, 5}; len(accountStore.lockLog) != len(want) || accountStore.lockLog[0] != want[0] || accountStore.lockLog[1] != want[1] {
+		t.Fatalf("lock order = %v, want %v", accountStore.lockLog, want)
+	}
+
+	if from.Balance.Cmp(big.NewInt(70)) != 0 {
+		t.Fatalf("from.Balance = %s, want 70", from.Balance.String())
+	}
+	if to.Balance.Cmp(big.NewInt(30)) != 0 {
+		t.Fatalf("to.Balance = %s, want 30", to.Balance.String())
+	}
+}
+
+func TestTransfer_InsufficientFunds(t *testing.T) {
+	from := newAccount(1, 10)
+	to := newAccount(2, 0)
+
+	app, _, transactionStore := newTestApp(from, to)
+	ctx := authctx.WithAccountID(context.Background(), 1)
+
+	_, err := app.Transfer(ctx, 1, 2, big.NewInt(50), "")
+	if err != errTransferInsufficientFunds {
+		t.Fatalf("Transfer() error = %v, want %v", err, errTransferInsufficientFunds)
+	}
+
+	if from.Balance.Cmp(big.NewInt(10)) != 0 {
+		t.Fatalf("from.Balance = %s, want unchanged 10", from.Balance.String())
+	}
+	if transactionStore.nextID != 0 {
+		t.Fatalf("expected no transaction to be created on overdraft, got nextID = %d", transactionStore.nextID)
+	}
+}
+
+func TestTransfer_IdempotentReplay(t *testing.T) {
+	from := newAccount(1, 100)
+	to := newAccount(2, 0)
+
+	app, _, transactionStore := newTestApp(from, to)
+	ctx := authctx.WithAccountID(context.Background(), 1)
+
+	first, err := app.Transfer(ctx, 1, 2, big.NewInt(40), "replay-key")
+	if err != nil {
+		t.Fatalf("first Transfer() error = %v, want nil", err)
+	}
+
+	second, err := app.Transfer(ctx, 1, 2, big.NewInt(40), "replay-key")
+	if err != nil {
+		t.Fatalf("second Transfer() error = %v, want nil", err)
+	}
+
+	if second.ID != first.ID {
+		t.Fatalf("replayed Transfer returned a different transaction: first.ID = %d, second.ID = %d", first.ID, second.ID)
+	}
+
+	if transactionStore.nextID != 1 {
+		t.Fatalf("expected exactly one transaction to be created, got nextID = %d", transactionStore.nextID)
+	}
+	if from.Balance.Cmp(big.NewInt(60)) != 0 {
+		t.Fatalf("from.Balance = %s, want 60 (debited only once)", from.Balance.String())
+	}
+}
@@ -0,0 +1,29 @@
+package account
+
+import (
+	"net/http"
+
+	apperrors "github.com/patrickchagastavares/conta-corrent/internal/errors"
+	"github.com/patrickchagastavares/conta-corrent/internal/models"
+)
+
+var (
+	errAccountList          = models.NewError(apperrors.ErrAccountList, http.StatusInternalServerError, "Não foi possível listar as contas", nil)
+	errAccountID            = models.NewError(apperrors.ErrAccountIDInvalid, http.StatusBadRequest, "O id da conta é inválido", nil)
+	errAccountBalanceByID   = models.NewError(apperrors.ErrAccountBalanceByID, http.StatusInternalServerError, "Não foi possível buscar o saldo da conta", nil)
+	errAccountCreate        = models.NewError(apperrors.ErrAccountCreate, http.StatusInternalServerError, "Não foi possível criar a conta", nil)
+	errAccountCpfExists     = models.NewError(apperrors.ErrAccountDocumentExists, http.StatusConflict, "O documento já está cadastrado", nil)
+	errAccountCpfNotInput   = models.NewError(apperrors.ErrAccountDocumentNotInput, http.StatusBadRequest, "O documento é obrigatório", nil)
+	errAccountGet           = models.NewError(apperrors.ErrAccountGet, http.StatusInternalServerError, "Não foi possível buscar a conta", nil)
+	errAccountBalance       = models.NewError(apperrors.ErrAccountBalanceInvalid, http.StatusBadRequest, "O saldo da conta é inválido", nil)
+	errAccountUpdateBalance = models.NewError(apperrors.ErrAccountUpdateBalance, http.StatusInternalServerError, "Não foi possível atualizar o saldo da conta", nil)
+	errAccountForbidden     = models.NewError(apperrors.ErrAccountForbidden, http.StatusForbidden, "Você não tem permissão para operar nesta conta", nil)
+	errSecretRequired       = models.NewError(apperrors.ErrSecretRequired, http.StatusBadRequest, "A senha é obrigatoria", nil)
+
+	errAccountCredentialsInvalid = models.NewError(apperrors.ErrAccountCredentialsInvalid, http.StatusUnauthorized, "Cpf ou senha inválidos", nil)
+
+	errTransferSameAccount       = models.NewError(apperrors.ErrTransferSameAccount, http.StatusBadRequest, "Não é possível transferir para a mesma conta", nil)
+	errTransferAmountInvalid     = models.NewError(apperrors.ErrTransferAmountInvalid, http.StatusBadRequest, "O valor da transferência é inválido", nil)
+	errTransferInsufficientFunds = models.NewError(apperrors.ErrAccountInsufficientFunds, http.StatusBadRequest, "Saldo insuficiente para realizar a transferência", nil)
+	errTransferCreate            = models.NewError(apperrors.ErrTransferCreate, http.StatusInternalServerError, "Não foi possível realizar a transferência", nil)
+)
@@ -0,0 +1,16 @@
+package transaction
+
+import (
+	"net/http"
+
+	apperrors "github.com/patrickchagastavares/conta-corrent/internal/errors"
+	"github.com/patrickchagastavares/conta-corrent/internal/models"
+)
+
+var (
+	errTransactionID        = models.NewError(apperrors.ErrTransactionIDInvalid, http.StatusBadRequest, "O id da transferência é inválido", nil)
+	errTransactionAccountID = models.NewError(apperrors.ErrTransactionAccountIDInvalid, http.StatusBadRequest, "O id da conta é inválido", nil)
+	errTransactionGet       = models.NewError(apperrors.ErrTransactionGet, http.StatusInternalServerError, "Não foi possível buscar a transferência", nil)
+	errTransactionList      = models.NewError(apperrors.ErrTransactionList, http.StatusInternalServerError, "Não foi possível listar as transferências", nil)
+	errTransactionForbidden = models.NewError(apperrors.ErrTransactionForbidden, http.StatusForbidden, "Você não tem permissão para acessar esta transferência", nil)
+)
@@ -0,0 +1,81 @@
+package transaction
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/patrickchagastavares/conta-corrent/authctx"
+	"github.com/patrickchagastavares/conta-corrent/internal/models"
+	"github.com/patrickchagastavares/conta-corrent/store"
+)
+
+// App expõe a leitura do ledger de transferencias
+type App interface {
+	GetByID(ctx context.Context, id int) (*models.Transaction, error)
+	ListByAccount(ctx context.Context, accountID int) ([]*models.Transaction, error)
+}
+
+type appImpl struct {
+	stores *store.Container
+}
+
+// NewApp cria uma nova instancia do modulo transaction
+func NewApp(stores *store.Container) App {
+	return &appImpl{
+		stores: stores,
+	}
+}
+
+func (a *appImpl) GetByID(ctx context.Context, id int) (*models.Transaction, error) {
+	if id <= 0 {
+		return nil, errTransactionID
+	}
+
+	transaction, err := a.stores.Transaction.GetByID(ctx, id)
+	if err != nil {
+		return nil, errTransactionGet
+	}
+
+	callerID, ok := authctx.AccountID(ctx)
+	if !ok || (callerID != transaction.FromAccountID && callerID != transaction.ToAccountID) {
+		return nil, errTransactionForbidden
+	}
+
+	return transaction, nil
+}
+
+func (a *appImpl) ListByAccount(ctx context.Context, accountID int) ([]*models.Transaction, error) {
+	if accountID <= 0 {
+		return nil, errTransactionAccountID
+	}
+
+	if callerID, ok := authctx.AccountID(ctx); !ok || callerID != accountID {
+		return nil, errTransactionForbidden
+	}
+
+	transactions, err := a.stores.Transaction.ListByAccount(ctx, accountID)
+	if err != nil {
+		return nil, errTransactionList
+	}
+
+	return transactions, nil
+}
+
+// NewEntries monta o par de lancamentos imutaveis (debito/credito) referentes
+// a uma transferencia entre fromAccountID e toAccountID
+func NewEntries(transactionID, fromAccountID, toAccountID int, amount *big.Int) []*models.Entry {
+	return []*models.Entry{
+		{
+			TransactionID: transactionID,
+			AccountID:     fromAccountID,
+			Direction:     models.EntryDirectionDebit,
+			Amount:        *amount,
+		},
+		{
+			TransactionID: transactionID,
+			AccountID:     toAccountID,
+			Direction:     models.EntryDirectionCredit,
+			Amount:        *amount,
+		},
+	}
+}
@@ -0,0 +1,176 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	jwt "github.com/dgrijalva/jwt-go"
+
+	"github.com/patrickchagastavares/conta-corrent/app/account"
+	"github.com/patrickchagastavares/conta-corrent/internal/models"
+	"github.com/patrickchagastavares/conta-corrent/store"
+	"github.com/patrickchagastavares/conta-corrent/utils/logger"
+)
+
+// Claims e o conjunto de informações carregadas no access token
+type Claims struct {
+	AccountID int `json:"account_id"`
+	jwt.StandardClaims
+}
+
+// App expõe o ciclo de vida da sessão autenticada: login, renovação do
+// access token via refresh token e validação de um access token recebido
+type App interface {
+	Login(ctx context.Context, cpf, secret string) (accessToken, refreshToken string, err error)
+	Refresh(ctx context.Context, refreshToken string) (accessToken, newRefreshToken string, err error)
+	Validate(ctx context.Context, accessToken string) (*Claims, error)
+}
+
+type appImpl struct {
+	stores  *store.Container
+	account account.App
+	config  *Config
+}
+
+// NewApp cria uma nova instancia do modulo auth
+func NewApp(stores *store.Container, account account.App, config *Config) App {
+	return &appImpl{
+		stores:  stores,
+		account: account,
+		config:  config,
+	}
+}
+
+func (a *appImpl) Login(ctx context.Context, cpf, secret string) (string, string, error) {
+	if cpf == "" {
+		return "", "", errCPFRequired
+	}
+
+	if secret == "" {
+		return "", "", errSecretRequired
+	}
+
+	account, err := a.account.Authenticate(ctx, cpf, secret)
+	if err != nil {
+		return "", "", errCredentialsInvalid
+	}
+
+	return a.issueTokenPair(ctx, account.ID)
+}
+
+func (a *appImpl) Refresh(ctx context.Context, refreshToken string) (string, string, error) {
+	if refreshToken == "" {
+		return "", "", errRefreshTokenInvalid
+	}
+
+	tokenHash := hashRefreshToken(refreshToken)
+
+	stored, err := a.stores.RefreshToken.GetByTokenHash(ctx, tokenHash)
+	if err != nil {
+		return "", "", errRefreshTokenInvalid
+	}
+
+	if stored.Revoked() || stored.Expired() {
+		return "", "", errRefreshTokenInvalid
+	}
+
+	// rotaciona o refresh token: o antigo é revogado antes de o novo par ser emitido
+	if err := a.stores.RefreshToken.Revoke(ctx, stored.ID); err != nil {
+		logger.ErrorContext(ctx, err)
+		return "", "", errLogin
+	}
+
+	return a.issueTokenPair(ctx, stored.AccountID)
+}
+
+func (a *appImpl) Validate(ctx context.Context, accessToken string) (*Claims, error) {
+	if accessToken == "" {
+		return nil, errTokenRequired
+	}
+
+	claims := &Claims{}
+
+	_, err := jwt.ParseWithClaims(accessToken, claims, func(token *jwt.Token) (interface{}, error) {
+		keyID, ok := token.Header["kid"].(string)
+		if !ok {
+			return nil, errTokenInvalid
+		}
+
+		key, ok := a.config.SigningKeys[keyID]
+		if !ok {
+			return nil, errTokenInvalid
+		}
+
+		return key, nil
+	})
+	if err != nil {
+		if validationErr, ok := err.(*jwt.ValidationError); ok && validationErr.Errors&jwt.ValidationErrorExpired != 0 {
+			return nil, errTokenExpired
+		}
+		return nil, errTokenInvalid
+	}
+
+	return claims, nil
+}
+
+// issueTokenPair emite um novo access token (JWT) e um novo refresh token
+// (opaco, persistido apenas como hash) para a conta informada
+func (a *appImpl) issueTokenPair(ctx context.Context, accountID int) (string, string, error) {
+	now := time.Now()
+
+	claims := &Claims{
+		AccountID: accountID,
+		StandardClaims: jwt.StandardClaims{
+			IssuedAt:  now.Unix(),
+			ExpiresAt: now.Add(a.config.AccessTokenTTL).Unix(),
+		},
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	token.Header["kid"] = a.config.ActiveKeyID
+
+	accessToken, err := token.SignedString(a.config.SigningKeys[a.config.ActiveKeyID])
+	if err != nil {
+		logger.ErrorContext(ctx, err)
+		return "", "", errLogin
+	}
+
+	refreshToken, err := newRefreshTokenValue()
+	if err != nil {
+		logger.ErrorContext(ctx, err)
+		return "", "", errLogin
+	}
+
+	refreshTokenEntry := &models.RefreshToken{
+		AccountID: accountID,
+		TokenHash: hashRefreshToken(refreshToken),
+		ExpiresAt: now.Add(a.config.RefreshTokenTTL),
+	}
+
+	if err := a.stores.RefreshToken.Create(ctx, refreshTokenEntry); err != nil {
+		logger.ErrorContext(ctx, err)
+		return "", "", errLogin
+	}
+
+	return accessToken, refreshToken, nil
+}
+
+// newRefreshTokenValue gera um refresh token opaco aleatório
+func newRefreshTokenValue() (string, error) {
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(raw), nil
+}
+
+// hashRefreshToken calcula o hash persistido do refresh token; apenas o hash
+// fica em banco, nunca o valor bruto devolvido ao cliente
+func hashRefreshToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return fmt.Sprintf("%x", sum)
+}
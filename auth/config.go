@@ -0,0 +1,73 @@
+package auth
+
+import (
+	"os"
+	"strconv"
+	"time"
+)
+
+const (
+	defaultAccessTokenTTL  = 15 * time.Minute
+	defaultRefreshTokenTTL = 7 * 24 * time.Hour
+)
+
+// Config centraliza os parametros do subsistema de autenticacao. As chaves
+// de assinatura ficam indexadas por key-ID para permitir rotação: tokens
+// antigos continuam validos com a chave que os assinou enquanto o signer
+// atual passa a usar ActiveKeyID.
+type Config struct {
+	SigningKeys     map[string][]byte
+	ActiveKeyID     string
+	AccessTokenTTL  time.Duration
+	RefreshTokenTTL time.Duration
+}
+
+// NewConfigFromEnv monta a configuração do auth a partir de variáveis de ambiente:
+//
+//	AUTH_SIGNING_KEY_ID      key-ID da chave ativa (default "1")
+//	AUTH_SIGNING_KEY_<ID>    chave de assinatura correspondente ao key-ID <ID>
+//	AUTH_ACCESS_TOKEN_TTL    TTL do access token em segundos (default 900)
+//	AUTH_REFRESH_TOKEN_TTL   TTL do refresh token em segundos (default 604800)
+func NewConfigFromEnv() (*Config, error) {
+	activeKeyID := os.Getenv("AUTH_SIGNING_KEY_ID")
+	if activeKeyID == "" {
+		activeKeyID = "1"
+	}
+
+	activeKey := os.Getenv("AUTH_SIGNING_KEY_" + activeKeyID)
+	if activeKey == "" {
+		return nil, errSigningKeyMissing
+	}
+
+	cfg := &Config{
+		SigningKeys:     map[string][]byte{activeKeyID: []byte(activeKey)},
+		ActiveKeyID:     activeKeyID,
+		AccessTokenTTL:  defaultAccessTokenTTL,
+		RefreshTokenTTL: defaultRefreshTokenTTL,
+	}
+
+	if v := os.Getenv("AUTH_ACCESS_TOKEN_TTL"); v != "" {
+		seconds, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, errSigningKeyMissing
+		}
+		cfg.AccessTokenTTL = time.Duration(seconds) * time.Second
+	}
+
+	if v := os.Getenv("AUTH_REFRESH_TOKEN_TTL"); v != "" {
+		seconds, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, errSigningKeyMissing
+		}
+		cfg.RefreshTokenTTL = time.Duration(seconds) * time.Second
+	}
+
+	return cfg, nil
+}
+
+// AddSigningKey registra uma chave de assinatura adicional, permitindo que
+// tokens assinados com key-IDs antigos continuem sendo validados durante a
+// rotação de chaves
+func (c *Config) AddSigningKey(keyID string, key []byte) {
+	c.SigningKeys[keyID] = key
+}
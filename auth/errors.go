@@ -0,0 +1,20 @@
+package auth
+
+import (
+	"net/http"
+
+	apperrors "github.com/patrickchagastavares/conta-corrent/internal/errors"
+	"github.com/patrickchagastavares/conta-corrent/internal/models"
+)
+
+var (
+	errCPFRequired         = models.NewError(apperrors.ErrDocumentRequired, http.StatusBadRequest, "O cpf é obrigatório", nil)
+	errSecretRequired      = models.NewError(apperrors.ErrSecretRequired, http.StatusBadRequest, "A senha é obrigatoria", nil)
+	errCredentialsInvalid  = models.NewError(apperrors.ErrAccountCredentialsInvalid, http.StatusUnauthorized, "Cpf ou senha inválidos", nil)
+	errTokenRequired       = models.NewError(apperrors.ErrTokenRequired, http.StatusUnauthorized, "O token é obrigatório", nil)
+	errTokenInvalid        = models.NewError(apperrors.ErrTokenInvalid, http.StatusUnauthorized, "O token é inválido", nil)
+	errTokenExpired        = models.NewError(apperrors.ErrTokenExpired, http.StatusUnauthorized, "O token expirou", nil)
+	errRefreshTokenInvalid = models.NewError(apperrors.ErrRefreshTokenInvalid, http.StatusUnauthorized, "O refresh token é inválido", nil)
+	errLogin               = models.NewError(apperrors.ErrAuthInternal, http.StatusInternalServerError, "Não foi possível autenticar", nil)
+	errSigningKeyMissing   = models.NewError(apperrors.ErrAuthConfigInvalid, http.StatusInternalServerError, "Configuração de autenticação inválida", nil)
+)
@@ -0,0 +1,43 @@
+package auth
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/patrickchagastavares/conta-corrent/authctx"
+	apperrors "github.com/patrickchagastavares/conta-corrent/internal/errors"
+)
+
+// Middleware valida o access token enviado no header Authorization (formato
+// "Bearer <token>") e injeta o account_id autenticado no contexto da
+// requisição, via authctx. Requisições sem um token válido recebem um
+// application/problem+json (RFC 7807), traduzido conforme o Accept-Language.
+func Middleware(app App, catalog *apperrors.Catalog) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			token := bearerToken(r.Header.Get("Authorization"))
+
+			claims, err := app.Validate(r.Context(), token)
+			if err != nil {
+				if err == errTokenRequired || err == errTokenInvalid || err == errTokenExpired {
+					apperrors.WriteProblem(w, r, catalog, err)
+					return
+				}
+				apperrors.WriteProblem(w, r, catalog, errTokenInvalid)
+				return
+			}
+
+			ctx := authctx.WithAccountID(r.Context(), claims.AccountID)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// bearerToken extrai o token do header Authorization no formato "Bearer <token>"
+func bearerToken(header string) string {
+	const prefix = "Bearer "
+	if !strings.HasPrefix(header, prefix) {
+		return ""
+	}
+	return strings.TrimPrefix(header, prefix)
+}
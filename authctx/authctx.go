@@ -0,0 +1,21 @@
+// Package authctx carrega a identidade do chamador autenticado através do
+// context.Context, desacoplando quem consome essa identidade (app, store) de
+// como ela foi obtida (JWT, sessão, etc).
+package authctx
+
+import "context"
+
+type contextKey string
+
+const accountIDKey contextKey = "account_id"
+
+// WithAccountID retorna um novo contexto carregando o id da conta autenticada
+func WithAccountID(ctx context.Context, accountID int) context.Context {
+	return context.WithValue(ctx, accountIDKey, accountID)
+}
+
+// AccountID recupera o id da conta autenticada no contexto, caso exista
+func AccountID(ctx context.Context) (int, bool) {
+	accountID, ok := ctx.Value(accountIDKey).(int)
+	return accountID, ok
+}
@@ -0,0 +1,75 @@
+package errors
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/BurntSushi/toml"
+	"golang.org/x/text/language"
+)
+
+var supportedLocales = map[language.Tag]string{
+	language.BrazilianPortuguese: "pt-BR",
+	language.English:             "en",
+}
+
+// Catalog traduz Codes para mensagens localizadas, carregadas de um arquivo
+// TOML por idioma (ex: locales/pt-BR.toml, locales/en.toml), no formato
+// CODE = "mensagem traduzida".
+type Catalog struct {
+	messages map[language.Tag]map[Code]string
+	tags     []language.Tag
+	matcher  language.Matcher
+}
+
+// NewCatalog carrega os catálogos de mensagens a partir de dir, esperando um
+// arquivo <idioma>.toml por idioma suportado
+func NewCatalog(dir string) (*Catalog, error) {
+	messages := make(map[language.Tag]map[Code]string, len(supportedLocales))
+	tags := make([]language.Tag, 0, len(supportedLocales))
+
+	for tag, locale := range supportedLocales {
+		path := filepath.Join(dir, locale+".toml")
+
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("errors: não foi possível carregar o catálogo %s: %w", path, err)
+		}
+
+		raw := map[string]string{}
+		if err := toml.Unmarshal(data, &raw); err != nil {
+			return nil, fmt.Errorf("errors: catálogo %s inválido: %w", path, err)
+		}
+
+		translated := make(map[Code]string, len(raw))
+		for code, message := range raw {
+			translated[Code(code)] = message
+		}
+
+		messages[tag] = translated
+		tags = append(tags, tag)
+	}
+
+	return &Catalog{messages: messages, tags: tags, matcher: language.NewMatcher(tags)}, nil
+}
+
+// Translate devolve a mensagem de code no idioma mais apropriado para o
+// header Accept-Language informado, com fallback para pt-BR e, por fim,
+// para o próprio Code caso nenhum catálogo tenha tradução
+func (c *Catalog) Translate(code Code, acceptLanguage string) string {
+	desired, _, _ := language.ParseAcceptLanguage(acceptLanguage)
+	_, index, confidence := c.matcher.Match(desired...)
+
+	if confidence != language.No {
+		if msg, ok := c.messages[c.tags[index]][code]; ok {
+			return msg
+		}
+	}
+
+	if msg, ok := c.messages[language.BrazilianPortuguese][code]; ok {
+		return msg
+	}
+
+	return string(code)
+}
@@ -0,0 +1,77 @@
+// Package errors define o catálogo de erros de negocio da aplicação: cada
+// erro carrega um Code estável e independente de idioma, usado tanto para
+// localizar a mensagem exibida ao cliente quanto para permitir que callers
+// (e testes) verifiquem qual erro ocorreu sem depender do texto.
+package errors
+
+// Code identifica univocamente um erro de negocio
+type Code string
+
+const (
+	ErrInternal Code = "ERR_INTERNAL"
+
+	ErrDocumentRequired    Code = "ERR_DOCUMENT_REQUIRED"
+	ErrDocumentSizeInvalid Code = "ERR_DOCUMENT_SIZE_INVALID"
+	ErrDocumentInvalid     Code = "ERR_DOCUMENT_INVALID"
+	ErrNameRequired        Code = "ERR_NAME_REQUIRED"
+	ErrSecretRequired      Code = "ERR_SECRET_REQUIRED"
+
+	ErrAccountList               Code = "ERR_ACCOUNT_LIST"
+	ErrAccountIDInvalid          Code = "ERR_ACCOUNT_ID_INVALID"
+	ErrAccountBalanceByID        Code = "ERR_ACCOUNT_BALANCE_BY_ID"
+	ErrAccountCreate             Code = "ERR_ACCOUNT_CREATE"
+	ErrAccountDocumentExists     Code = "ERR_ACCOUNT_DOCUMENT_EXISTS"
+	ErrAccountDocumentNotInput   Code = "ERR_ACCOUNT_DOCUMENT_NOT_INPUT"
+	ErrAccountGet                Code = "ERR_ACCOUNT_GET"
+	ErrAccountBalanceInvalid     Code = "ERR_ACCOUNT_BALANCE_INVALID"
+	ErrAccountUpdateBalance      Code = "ERR_ACCOUNT_UPDATE_BALANCE"
+	ErrAccountForbidden          Code = "ERR_ACCOUNT_FORBIDDEN"
+	ErrAccountCredentialsInvalid Code = "ERR_ACCOUNT_CREDENTIALS_INVALID"
+
+	ErrTransferSameAccount      Code = "ERR_TRANSFER_SAME_ACCOUNT"
+	ErrTransferAmountInvalid    Code = "ERR_TRANSFER_AMOUNT_INVALID"
+	ErrAccountInsufficientFunds Code = "ERR_ACCOUNT_INSUFFICIENT_FUNDS"
+	ErrTransferCreate           Code = "ERR_TRANSFER_CREATE"
+
+	ErrTransactionIDInvalid        Code = "ERR_TRANSACTION_ID_INVALID"
+	ErrTransactionAccountIDInvalid Code = "ERR_TRANSACTION_ACCOUNT_ID_INVALID"
+	ErrTransactionGet              Code = "ERR_TRANSACTION_GET"
+	ErrTransactionList             Code = "ERR_TRANSACTION_LIST"
+	ErrTransactionForbidden        Code = "ERR_TRANSACTION_FORBIDDEN"
+
+	ErrTokenRequired       Code = "ERR_TOKEN_REQUIRED"
+	ErrTokenInvalid        Code = "ERR_TOKEN_INVALID"
+	ErrTokenExpired        Code = "ERR_TOKEN_EXPIRED"
+	ErrRefreshTokenInvalid Code = "ERR_REFRESH_TOKEN_INVALID"
+	ErrAuthInternal        Code = "ERR_AUTH_INTERNAL"
+	ErrAuthConfigInvalid   Code = "ERR_AUTH_CONFIG_INVALID"
+)
+
+// Error e um erro de negocio identificado por um Code estável e um status
+// http associado. Message carrega uma mensagem default (pt-BR) para uso em
+// logs e por callers que não passam pela camada HTTP; respostas ao cliente
+// devem preferir Catalog.Translate(Code, ...).
+type Error struct {
+	Code    Code
+	Status  int
+	Message string
+	Err     error
+}
+
+// New cria um novo Error de negocio
+func New(code Code, status int, message string, err error) *Error {
+	return &Error{
+		Code:    code,
+		Status:  status,
+		Message: message,
+		Err:     err,
+	}
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,44 @@
+package errors
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// Problem e o corpo de erro HTTP no formato RFC 7807 (application/problem+json)
+type Problem struct {
+	Type   string `json:"type"`
+	Title  string `json:"title"`
+	Status int    `json:"status"`
+	Detail string `json:"detail"`
+	Code   Code   `json:"code"`
+}
+
+const problemTypeBaseURL = "https://conta-corrente.dev/errors/"
+
+// WriteProblem escreve err como application/problem+json, traduzindo o
+// Detail de acordo com o header Accept-Language da requisição. Erros que não
+// são *Error são tratados como falha interna e não vazam a mensagem original.
+func WriteProblem(w http.ResponseWriter, r *http.Request, catalog *Catalog, err error) {
+	bizErr, ok := err.(*Error)
+	if !ok {
+		bizErr = New(ErrInternal, http.StatusInternalServerError, "", err)
+	}
+
+	detail := bizErr.Message
+	if catalog != nil {
+		detail = catalog.Translate(bizErr.Code, r.Header.Get("Accept-Language"))
+	}
+
+	problem := Problem{
+		Type:   problemTypeBaseURL + string(bizErr.Code),
+		Title:  http.StatusText(bizErr.Status),
+		Status: bizErr.Status,
+		Detail: detail,
+		Code:   bizErr.Code,
+	}
+
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(problem.Status)
+	_ = json.NewEncoder(w).Encode(problem)
+}
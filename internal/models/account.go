@@ -0,0 +1,51 @@
+package models
+
+import (
+	"math/big"
+	"net/http"
+	"time"
+
+	apperrors "github.com/patrickchagastavares/conta-corrent/internal/errors"
+)
+
+var (
+	errDocumentRequired = NewError(apperrors.ErrDocumentRequired, http.StatusBadRequest, "O documento é obrigatório", nil)
+	errNameRequired     = NewError(apperrors.ErrNameRequired, http.StatusBadRequest, "O nome é obrigatório", nil)
+	errSecretRequired   = NewError(apperrors.ErrSecretRequired, http.StatusBadRequest, "A senha é obrigatoria", nil)
+)
+
+// Account e o tipo de dominio, persistencia-agnostico, de uma conta corrente.
+// A representacao usada pela camada HTTP (AccountDTO) e pela camada de
+// persistencia (AccountRow) ficam em arquivos separados, com funcoes
+// explicitas de conversao de/para este tipo.
+type Account struct {
+	ID         int
+	Name       string
+	Document   Document
+	SecretHash string
+	Secret     string
+	Balance    big.Int
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// validate if account is valid
+func (a *Account) Validate() error {
+	if a.Name == "" {
+		return errNameRequired
+	}
+
+	if a.Secret == "" {
+		return errSecretRequired
+	}
+
+	if a.Document.Digits == "" {
+		return errDocumentRequired
+	}
+
+	if err := a.Document.Validate(); err != nil {
+		return err
+	}
+
+	return nil
+}
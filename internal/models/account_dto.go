@@ -0,0 +1,34 @@
+package models
+
+import "time"
+
+// AccountDTO e a representacao de Account trafegada pela API HTTP
+type AccountDTO struct {
+	ID        int       `json:"id,omitempty"`
+	Name      string    `json:"name"`
+	Document  Document  `json:"document"`
+	Secret    string    `json:"secret,omitempty"`
+	Balance   string    `json:"balance,omitempty"`
+	CreatedAt time.Time `json:"created_at,omitempty"`
+}
+
+// NewAccountDTO converte um Account de dominio para o DTO exposto pela API
+func NewAccountDTO(a *Account) *AccountDTO {
+	return &AccountDTO{
+		ID:        a.ID,
+		Name:      a.Name,
+		Document:  a.Document,
+		Balance:   a.Balance.String(),
+		CreatedAt: a.CreatedAt,
+	}
+}
+
+// ToDomain converte o DTO recebido pela API para o Account de dominio
+func (dto *AccountDTO) ToDomain() *Account {
+	return &Account{
+		ID:       dto.ID,
+		Name:     dto.Name,
+		Document: dto.Document,
+		Secret:   dto.Secret,
+	}
+}
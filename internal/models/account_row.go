@@ -0,0 +1,61 @@
+package models
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/patrickchagastavares/conta-corrent/utils/password"
+)
+
+// AccountRow e a representacao de Account persistida na tabela accounts.
+// SecretSalt é mantido apenas para ler contas criadas antes do hashing
+// versionado (em que o salt vivia em uma coluna própria); contas novas não
+// preenchem essa coluna, pois o salt passa a viajar embutido em SecretHash.
+type AccountRow struct {
+	ID         int       `db:"id"`
+	Name       string    `db:"name"`
+	Document   string    `db:"document"`
+	SecretHash string    `db:"secret_hash"`
+	SecretSalt string    `db:"secret_salt"`
+	Balance    string    `db:"balance"`
+	CreatedAt  time.Time `db:"created_at"`
+	UpdatedAt  time.Time `db:"updated_at"`
+}
+
+// NewAccountRow converte o Account de dominio para a linha a ser persistida
+func NewAccountRow(a *Account) *AccountRow {
+	return &AccountRow{
+		ID:         a.ID,
+		Name:       a.Name,
+		Document:   a.Document.Digits,
+		SecretHash: a.SecretHash,
+		Balance:    a.Balance.String(),
+		CreatedAt:  a.CreatedAt,
+		UpdatedAt:  a.UpdatedAt,
+	}
+}
+
+// ToDomain converte a linha persistida para o Account de dominio
+func (r *AccountRow) ToDomain() (*Account, error) {
+	balance, ok := new(big.Int).SetString(r.Balance, 10)
+	if !ok {
+		return nil, fmt.Errorf("models: invalid balance %q for account %d", r.Balance, r.ID)
+	}
+
+	secretHash := r.SecretHash
+	if r.SecretSalt != "" && !strings.HasPrefix(secretHash, "$") {
+		secretHash = password.EncodeLegacy(secretHash, r.SecretSalt)
+	}
+
+	return &Account{
+		ID:         r.ID,
+		Name:       r.Name,
+		Document:   NewDocument(r.Document),
+		SecretHash: secretHash,
+		Balance:    *balance,
+		CreatedAt:  r.CreatedAt,
+		UpdatedAt:  r.UpdatedAt,
+	}, nil
+}
@@ -0,0 +1,152 @@
+package models
+
+import (
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"strconv"
+
+	apperrors "github.com/patrickchagastavares/conta-corrent/internal/errors"
+)
+
+const (
+	cpfSize  = 11
+	cnpjSize = 14
+)
+
+// DocumentKind identifica se um Document e um CPF (pessoa fisica) ou um CNPJ
+// (pessoa juridica)
+type DocumentKind string
+
+const (
+	DocumentKindCPF  DocumentKind = "cpf"
+	DocumentKindCNPJ DocumentKind = "cnpj"
+)
+
+var (
+	errDocumentSizeInvalid = NewError(apperrors.ErrDocumentSizeInvalid, http.StatusBadRequest, "O documento deve ter 11 (cpf) ou 14 (cnpj) caracteres", nil)
+	errDocumentInvalid     = NewError(apperrors.ErrDocumentInvalid, http.StatusBadRequest, "O documento é inválido", nil)
+
+	documentInvalidKnown = map[string]bool{
+		"00000000000": true, "11111111111": true,
+		"22222222222": true, "33333333333": true,
+		"44444444444": true, "55555555555": true,
+		"66666666666": true, "77777777777": true,
+		"88888888888": true, "99999999999": true,
+		"00000000000000": true, "11111111111111": true,
+		"22222222222222": true, "33333333333333": true,
+		"44444444444444": true, "55555555555555": true,
+		"66666666666666": true, "77777777777777": true,
+		"88888888888888": true, "99999999999999": true,
+	}
+
+	cpfWeightTables = [][]int{
+		{10, 9, 8, 7, 6, 5, 4, 3, 2},
+		{11, 10, 9, 8, 7, 6, 5, 4, 3, 2},
+	}
+	cnpjWeightTables = [][]int{
+		{5, 4, 3, 2, 9, 8, 7, 6, 5, 4, 3, 2},
+		{6, 5, 4, 3, 2, 9, 8, 7, 6, 5, 4, 3, 2},
+	}
+
+	specialCharacterRegex = regexp.MustCompile("[^a-zA-Z0-9]+")
+)
+
+// Document e um CPF ou CNPJ, os dois tipos de documento aceitos para
+// identificar o titular de uma Account (pessoa fisica ou juridica)
+type Document struct {
+	Kind   DocumentKind
+	Digits string
+}
+
+// NewDocument recebe um cpf/cnpj em qualquer formato (com ou sem pontuação) e
+// devolve o Document correspondente, com a pontuação removida
+func NewDocument(raw string) Document {
+	return Document{Digits: specialCharacterRegex.ReplaceAllString(raw, "")}
+}
+
+// Validate valida o documento, identificando o Kind pela quantidade de
+// dígitos: 11 para CPF, 14 para CNPJ
+func (d *Document) Validate() error {
+	switch len(d.Digits) {
+	case cpfSize:
+		d.Kind = DocumentKindCPF
+		return d.checkDigits(cpfWeightTables)
+	case cnpjSize:
+		d.Kind = DocumentKindCNPJ
+		return d.checkDigits(cnpjWeightTables)
+	default:
+		return errDocumentSizeInvalid
+	}
+}
+
+func (d *Document) checkDigits(weightTables [][]int) error {
+	if documentInvalidKnown[d.Digits] {
+		return errDocumentInvalid
+	}
+
+	if !checkDigits(d.Digits, weightTables) {
+		return errDocumentInvalid
+	}
+
+	return nil
+}
+
+// checkDigits confere os dígitos verificadores de digits a partir das
+// weightTables informadas: cada tabela corresponde a um dígito verificador,
+// calculado como 11 - (soma ponderada % 11), com resultado >= 10 mapeado
+// para 0. Serve tanto para CPF quanto para CNPJ.
+func checkDigits(digits string, weightTables [][]int) bool {
+	base := digits[:len(digits)-len(weightTables)]
+
+	computed := base
+	for _, table := range weightTables {
+		sum := sumDigit(computed, table)
+
+		r := sum % 11
+		d := 0
+		if r >= 2 {
+			d = 11 - r
+		}
+
+		computed += strconv.Itoa(d)
+	}
+
+	return computed == digits
+}
+
+// sumDigit sum the digit
+func sumDigit(s string, table []int) int {
+	if len(s) != len(table) {
+		return 0
+	}
+
+	sum := 0
+
+	for i, v := range table {
+		c := string(s[i])
+		d, err := strconv.Atoi(c)
+		if err == nil {
+			sum += v * d
+		}
+	}
+
+	return sum
+}
+
+// MarshalJSON serializa o Document apenas pelos seus dígitos
+func (d Document) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.Digits)
+}
+
+// UnmarshalJSON aceita o documento formatado (ex: "123.456.789-09" ou
+// "12.345.678/0001-95") e guarda apenas os dígitos, removendo a pontuação
+func (d *Document) UnmarshalJSON(data []byte) error {
+	var raw string
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	*d = NewDocument(raw)
+	return nil
+}
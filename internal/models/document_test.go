@@ -0,0 +1,78 @@
+package models
+
+import "testing"
+
+func TestDocument_Validate_CPF(t *testing.T) {
+	tests := []struct {
+		name     string
+		digits   string
+		wantErr  error
+		wantKind DocumentKind
+	}{
+		{name: "valid cpf", digits: "12345678909", wantKind: DocumentKindCPF},
+		{name: "wrong check digits", digits: "12345678900", wantErr: errDocumentInvalid},
+		{name: "all repeating digits", digits: "11111111111", wantErr: errDocumentInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := Document{Digits: tt.digits}
+			err := d.Validate()
+			if err != tt.wantErr {
+				t.Fatalf("Validate() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil && d.Kind != tt.wantKind {
+				t.Fatalf("Kind = %v, want %v", d.Kind, tt.wantKind)
+			}
+		})
+	}
+}
+
+func TestDocument_Validate_CNPJ(t *testing.T) {
+	tests := []struct {
+		name     string
+		digits   string
+		wantErr  error
+		wantKind DocumentKind
+	}{
+		{name: "valid cnpj", digits: "12345678000195", wantKind: DocumentKindCNPJ},
+		{name: "wrong check digits", digits: "12345678000100", wantErr: errDocumentInvalid},
+		{name: "all repeating digits", digits: "11111111111111", wantErr: errDocumentInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := Document{Digits: tt.digits}
+			err := d.Validate()
+			if err != tt.wantErr {
+				t.Fatalf("Validate() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil && d.Kind != tt.wantKind {
+				t.Fatalf("Kind = %v, want %v", d.Kind, tt.wantKind)
+			}
+		})
+	}
+}
+
+func TestDocument_Validate_SizeInvalid(t *testing.T) {
+	tests := []string{"", "123", "123456789012345"}
+
+	for _, digits := range tests {
+		d := Document{Digits: digits}
+		if err := d.Validate(); err != errDocumentSizeInvalid {
+			t.Errorf("Validate(%q) error = %v, want %v", digits, err, errDocumentSizeInvalid)
+		}
+	}
+}
+
+func TestNewDocument_StripsFormatting(t *testing.T) {
+	d := NewDocument("123.456.789-09")
+	if d.Digits != "12345678909" {
+		t.Fatalf("Digits = %q, want %q", d.Digits, "12345678909")
+	}
+
+	d = NewDocument("12.345.678/0001-95")
+	if d.Digits != "12345678000195" {
+		t.Fatalf("Digits = %q, want %q", d.Digits, "12345678000195")
+	}
+}
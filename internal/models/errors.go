@@ -0,0 +1,12 @@
+package models
+
+import apperrors "github.com/patrickchagastavares/conta-corrent/internal/errors"
+
+// Error e uma referência para o tipo de erro de negocio do catálogo,
+// mantida aqui para não quebrar os callers que já importam models.Error
+type Error = apperrors.Error
+
+// NewError cria um novo Error de negocio associado a um Code do catálogo
+func NewError(code apperrors.Code, status int, message string, err error) *Error {
+	return apperrors.New(code, status, message, err)
+}
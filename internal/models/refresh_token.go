@@ -0,0 +1,59 @@
+package models
+
+import "time"
+
+// RefreshToken e o tipo de dominio de um refresh token emitido para uma conta.
+// Apenas o hash do token e persistido; o valor bruto e devolvido ao cliente
+// uma única vez, no momento da emissão.
+type RefreshToken struct {
+	ID        int
+	AccountID int
+	TokenHash string
+	ExpiresAt time.Time
+	RevokedAt *time.Time
+	CreatedAt time.Time
+}
+
+// Revoked indica se o refresh token já foi revogado
+func (r *RefreshToken) Revoked() bool {
+	return r.RevokedAt != nil
+}
+
+// Expired indica se o refresh token já expirou
+func (r *RefreshToken) Expired() bool {
+	return time.Now().After(r.ExpiresAt)
+}
+
+// RefreshTokenRow e a representacao de RefreshToken persistida na tabela refresh_tokens
+type RefreshTokenRow struct {
+	ID        int        `db:"id"`
+	AccountID int        `db:"account_id"`
+	TokenHash string     `db:"token_hash"`
+	ExpiresAt time.Time  `db:"expires_at"`
+	RevokedAt *time.Time `db:"revoked_at"`
+	CreatedAt time.Time  `db:"created_at"`
+}
+
+// NewRefreshTokenRow converte o RefreshToken de dominio para a linha a ser persistida
+func NewRefreshTokenRow(r *RefreshToken) *RefreshTokenRow {
+	return &RefreshTokenRow{
+		ID:        r.ID,
+		AccountID: r.AccountID,
+		TokenHash: r.TokenHash,
+		ExpiresAt: r.ExpiresAt,
+		RevokedAt: r.RevokedAt,
+		CreatedAt: r.CreatedAt,
+	}
+}
+
+// ToDomain converte a linha persistida para o RefreshToken de dominio
+func (r *RefreshTokenRow) ToDomain() *RefreshToken {
+	return &RefreshToken{
+		ID:        r.ID,
+		AccountID: r.AccountID,
+		TokenHash: r.TokenHash,
+		ExpiresAt: r.ExpiresAt,
+		RevokedAt: r.RevokedAt,
+		CreatedAt: r.CreatedAt,
+	}
+}
@@ -0,0 +1,48 @@
+package models
+
+import (
+	"math/big"
+	"time"
+)
+
+// TransactionStatus representa o estado atual de uma transferencia
+type TransactionStatus string
+
+const (
+	TransactionStatusPending   TransactionStatus = "pending"
+	TransactionStatusCommitted TransactionStatus = "committed"
+	TransactionStatusReversed  TransactionStatus = "reversed"
+)
+
+// Transaction e o tipo de dominio, persistencia-agnostico, de uma transferencia
+// entre contas. O lancamento contabil (debito/credito) fica registrado em duas
+// Entry imutaveis no ledger, mantendo a Transaction como o registro de
+// intencao/resultado da operacao.
+type Transaction struct {
+	ID             int
+	FromAccountID  int
+	ToAccountID    int
+	Amount         big.Int
+	Status         TransactionStatus
+	IdempotencyKey string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// EntryDirection indica se um lancamento do ledger e um debito ou credito
+type EntryDirection string
+
+const (
+	EntryDirectionDebit  EntryDirection = "debit"
+	EntryDirectionCredit EntryDirection = "credit"
+)
+
+// Entry e um lancamento imutavel do ledger, sempre associado a uma Transaction
+type Entry struct {
+	ID            int
+	TransactionID int
+	AccountID     int
+	Direction     EntryDirection
+	Amount        big.Int
+	CreatedAt     time.Time
+}
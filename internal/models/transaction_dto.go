@@ -0,0 +1,27 @@
+package models
+
+import "time"
+
+// TransactionDTO e a representacao de Transaction trafegada pela API HTTP
+type TransactionDTO struct {
+	ID             int               `json:"id,omitempty"`
+	FromAccountID  int               `json:"from_account_id"`
+	ToAccountID    int               `json:"to_account_id"`
+	Amount         string            `json:"amount"`
+	Status         TransactionStatus `json:"status"`
+	IdempotencyKey string            `json:"idempotency_key,omitempty"`
+	CreatedAt      time.Time         `json:"created_at,omitempty"`
+}
+
+// NewTransactionDTO converte uma Transaction de dominio para o DTO exposto pela API
+func NewTransactionDTO(t *Transaction) *TransactionDTO {
+	return &TransactionDTO{
+		ID:             t.ID,
+		FromAccountID:  t.FromAccountID,
+		ToAccountID:    t.ToAccountID,
+		Amount:         t.Amount.String(),
+		Status:         t.Status,
+		IdempotencyKey: t.IdempotencyKey,
+		CreatedAt:      t.CreatedAt,
+	}
+}
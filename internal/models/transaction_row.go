@@ -0,0 +1,91 @@
+package models
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+)
+
+// TransactionRow e a representacao de Transaction persistida na tabela transactions
+type TransactionRow struct {
+	ID             int               `db:"id"`
+	FromAccountID  int               `db:"from_account_id"`
+	ToAccountID    int               `db:"to_account_id"`
+	Amount         string            `db:"amount"`
+	Status         TransactionStatus `db:"status"`
+	IdempotencyKey string            `db:"idempotency_key"`
+	CreatedAt      time.Time         `db:"created_at"`
+	UpdatedAt      time.Time         `db:"updated_at"`
+}
+
+// NewTransactionRow converte a Transaction de dominio para a linha a ser persistida
+func NewTransactionRow(t *Transaction) *TransactionRow {
+	return &TransactionRow{
+		ID:             t.ID,
+		FromAccountID:  t.FromAccountID,
+		ToAccountID:    t.ToAccountID,
+		Amount:         t.Amount.String(),
+		Status:         t.Status,
+		IdempotencyKey: t.IdempotencyKey,
+		CreatedAt:      t.CreatedAt,
+		UpdatedAt:      t.UpdatedAt,
+	}
+}
+
+// ToDomain converte a linha persistida para a Transaction de dominio
+func (r *TransactionRow) ToDomain() (*Transaction, error) {
+	amount, ok := new(big.Int).SetString(r.Amount, 10)
+	if !ok {
+		return nil, fmt.Errorf("models: invalid amount %q for transaction %d", r.Amount, r.ID)
+	}
+
+	return &Transaction{
+		ID:             r.ID,
+		FromAccountID:  r.FromAccountID,
+		ToAccountID:    r.ToAccountID,
+		Amount:         *amount,
+		Status:         r.Status,
+		IdempotencyKey: r.IdempotencyKey,
+		CreatedAt:      r.CreatedAt,
+		UpdatedAt:      r.UpdatedAt,
+	}, nil
+}
+
+// EntryRow e a representacao de Entry persistida na tabela entries
+type EntryRow struct {
+	ID            int            `db:"id"`
+	TransactionID int            `db:"transaction_id"`
+	AccountID     int            `db:"account_id"`
+	Direction     EntryDirection `db:"direction"`
+	Amount        string         `db:"amount"`
+	CreatedAt     time.Time      `db:"created_at"`
+}
+
+// NewEntryRow converte a Entry de dominio para a linha a ser persistida
+func NewEntryRow(e *Entry) *EntryRow {
+	return &EntryRow{
+		ID:            e.ID,
+		TransactionID: e.TransactionID,
+		AccountID:     e.AccountID,
+		Direction:     e.Direction,
+		Amount:        e.Amount.String(),
+		CreatedAt:     e.CreatedAt,
+	}
+}
+
+// ToDomain converte a linha persistida para a Entry de dominio
+func (r *EntryRow) ToDomain() (*Entry, error) {
+	amount, ok := new(big.Int).SetString(r.Amount, 10)
+	if !ok {
+		return nil, fmt.Errorf("models: invalid amount %q for entry %d", r.Amount, r.ID)
+	}
+
+	return &Entry{
+		ID:            r.ID,
+		TransactionID: r.TransactionID,
+		AccountID:     r.AccountID,
+		Direction:     r.Direction,
+		Amount:        *amount,
+		CreatedAt:     r.CreatedAt,
+	}, nil
+}
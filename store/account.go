@@ -0,0 +1,29 @@
+package store
+
+import (
+	"context"
+
+	"github.com/patrickchagastavares/conta-corrent/internal/models"
+)
+
+// Account define o repositorio de persistencia das contas
+type Account interface {
+	List(ctx context.Context) ([]*models.Account, error)
+	GetBalanceByID(ctx context.Context, id int) (*models.Account, error)
+	// GetByCpf busca a conta pelo documento do titular (cpf ou cnpj, apenas dígitos)
+	GetByCpf(ctx context.Context, cpf string) (*models.Account, error)
+	GetByID(ctx context.Context, id int) (*models.Account, error)
+	// CpfExists confere se já existe conta para o documento informado (cpf ou cnpj)
+	CpfExists(ctx context.Context, cpf string) (bool, error)
+	Create(ctx context.Context, account *models.Account) error
+	UpdateBalance(ctx context.Context, account *models.Account) error
+
+	// UpdateSecretHash atualiza apenas o hash da senha da conta, usado para
+	// re-hashear o segredo no login quando o algoritmo armazenado está
+	// desatualizado (ver appImpl.Authenticate em app/account)
+	UpdateSecretHash(ctx context.Context, account *models.Account) error
+
+	// GetByIDForUpdate busca a conta travando a linha (SELECT ... FOR UPDATE),
+	// devendo ser chamado dentro de uma transacao aberta por store.Container.Atomic
+	GetByIDForUpdate(ctx context.Context, id int) (*models.Account, error)
+}
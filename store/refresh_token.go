@@ -0,0 +1,14 @@
+package store
+
+import (
+	"context"
+
+	"github.com/patrickchagastavares/conta-corrent/internal/models"
+)
+
+// RefreshToken define o repositorio de persistencia dos refresh tokens
+type RefreshToken interface {
+	Create(ctx context.Context, token *models.RefreshToken) error
+	GetByTokenHash(ctx context.Context, tokenHash string) (*models.RefreshToken, error)
+	Revoke(ctx context.Context, id int) error
+}
@@ -0,0 +1,32 @@
+package store
+
+import "context"
+
+// Atomic executa fn dentro de uma unica transacao de banco de dados, garantindo
+// que todas as operacoes realizadas em fn sejam aplicadas atomicamente
+type Atomic interface {
+	Atomic(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// Container agrupa todos os repositorios disponiveis na aplicacao
+type Container struct {
+	Account      Account
+	Transaction  Transaction
+	RefreshToken RefreshToken
+	atomic       Atomic
+}
+
+// NewContainer cria um novo Container de repositorios
+func NewContainer(account Account, transaction Transaction, refreshToken RefreshToken, atomic Atomic) *Container {
+	return &Container{
+		Account:      account,
+		Transaction:  transaction,
+		RefreshToken: refreshToken,
+		atomic:       atomic,
+	}
+}
+
+// Atomic executa fn dentro de uma unica transacao de banco de dados
+func (c *Container) Atomic(ctx context.Context, fn func(ctx context.Context) error) error {
+	return c.atomic.Atomic(ctx, fn)
+}
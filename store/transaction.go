@@ -0,0 +1,22 @@
+package store
+
+import (
+	"context"
+
+	"github.com/patrickchagastavares/conta-corrent/internal/models"
+)
+
+// Transaction define o repositorio de persistencia das transferencias e dos
+// lancamentos (entries) do ledger
+type Transaction interface {
+	// Create persiste transaction, atribuindo seu ID (analogo ao Create de
+	// Account), e em seguida chama newEntries com esse ID ja preenchido para
+	// obter e persistir os lancamentos correspondentes, na mesma transacao de
+	// banco. newEntries nunca deve ser chamada antes de transaction.ID ser
+	// atribuido.
+	Create(ctx context.Context, transaction *models.Transaction, newEntries func(transactionID int) []*models.Entry) error
+	GetByID(ctx context.Context, id int) (*models.Transaction, error)
+	GetByIdempotencyKey(ctx context.Context, idempotencyKey string) (*models.Transaction, error)
+	ListByAccount(ctx context.Context, accountID int) ([]*models.Transaction, error)
+	MarkReversed(ctx context.Context, id int) error
+}
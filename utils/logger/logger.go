@@ -0,0 +1,11 @@
+package logger
+
+import (
+	"context"
+	"log"
+)
+
+// ErrorContext loga um erro associado ao contexto da requisicao
+func ErrorContext(ctx context.Context, err error) {
+	log.Printf("[ERROR] %v", err)
+}
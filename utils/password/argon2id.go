@@ -0,0 +1,110 @@
+package password
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+)
+
+const argon2idPrefix = "$argon2id$"
+
+// Argon2idParams controla o custo do algoritmo argon2id
+type Argon2idParams struct {
+	MemoryKiB   uint32
+	Iterations  uint32
+	Parallelism uint8
+	SaltLength  uint32
+	KeyLength   uint32
+}
+
+// DefaultArgon2idParams retorna os parâmetros default: 64 MiB, t=3, p=2
+func DefaultArgon2idParams() Argon2idParams {
+	return Argon2idParams{
+		MemoryKiB:   64 * 1024,
+		Iterations:  3,
+		Parallelism: 2,
+		SaltLength:  16,
+		KeyLength:   32,
+	}
+}
+
+type argon2idHasher struct {
+	params Argon2idParams
+}
+
+// NewArgon2idHasher cria um Hasher argon2id com os parâmetros informados
+func NewArgon2idHasher(params Argon2idParams) Hasher {
+	return &argon2idHasher{params: params}
+}
+
+func (h *argon2idHasher) Prefix() string {
+	return argon2idPrefix
+}
+
+func (h *argon2idHasher) Hash(secret string) (string, error) {
+	salt := make([]byte, h.params.SaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+
+	hash := argon2.IDKey([]byte(secret), salt, h.params.Iterations, h.params.MemoryKiB, h.params.Parallelism, h.params.KeyLength)
+
+	return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2.Version,
+		h.params.MemoryKiB, h.params.Iterations, h.params.Parallelism,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(hash),
+	), nil
+}
+
+func (h *argon2idHasher) Verify(encoded, secret string) (bool, error) {
+	params, salt, hash, err := decodeArgon2id(encoded)
+	if err != nil {
+		return false, err
+	}
+
+	candidate := argon2.IDKey([]byte(secret), salt, params.Iterations, params.MemoryKiB, params.Parallelism, uint32(len(hash)))
+
+	return subtle.ConstantTimeCompare(candidate, hash) == 1, nil
+}
+
+func (h *argon2idHasher) NeedsUpgrade(encoded string) bool {
+	params, _, _, err := decodeArgon2id(encoded)
+	if err != nil {
+		return true
+	}
+
+	return params.MemoryKiB < h.params.MemoryKiB ||
+		params.Iterations < h.params.Iterations ||
+		params.Parallelism < h.params.Parallelism
+}
+
+// decodeArgon2id parseia um encoded hash no formato
+// $argon2id$v=19$m=...,t=...,p=...$salt$hash
+func decodeArgon2id(encoded string) (Argon2idParams, []byte, []byte, error) {
+	parts := strings.Split(encoded, "$")
+	if len(parts) != 6 || parts[1] != "argon2id" {
+		return Argon2idParams{}, nil, nil, fmt.Errorf("password: encoded hash argon2id inválido")
+	}
+
+	var params Argon2idParams
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &params.MemoryKiB, &params.Iterations, &params.Parallelism); err != nil {
+		return Argon2idParams{}, nil, nil, fmt.Errorf("password: encoded hash argon2id inválido: %w", err)
+	}
+
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return Argon2idParams{}, nil, nil, err
+	}
+
+	hash, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil {
+		return Argon2idParams{}, nil, nil, err
+	}
+
+	return params, salt, hash, nil
+}
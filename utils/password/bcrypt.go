@@ -0,0 +1,46 @@
+package password
+
+import "golang.org/x/crypto/bcrypt"
+
+const bcryptPrefix = "$2"
+
+type bcryptHasher struct {
+	cost int
+}
+
+// NewBcryptHasher cria um Hasher bcrypt com o cost informado, aceito apenas
+// para validar/atualizar hashes já existentes
+func NewBcryptHasher(cost int) Hasher {
+	return &bcryptHasher{cost: cost}
+}
+
+func (h *bcryptHasher) Prefix() string {
+	return bcryptPrefix
+}
+
+func (h *bcryptHasher) Hash(secret string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(secret), h.cost)
+	if err != nil {
+		return "", err
+	}
+	return string(hash), nil
+}
+
+func (h *bcryptHasher) Verify(encoded, secret string) (bool, error) {
+	err := bcrypt.CompareHashAndPassword([]byte(encoded), []byte(secret))
+	if err != nil {
+		if err == bcrypt.ErrMismatchedHashAndPassword {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+func (h *bcryptHasher) NeedsUpgrade(encoded string) bool {
+	cost, err := bcrypt.Cost([]byte(encoded))
+	if err != nil {
+		return true
+	}
+	return cost < h.cost
+}
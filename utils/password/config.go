@@ -0,0 +1,50 @@
+package password
+
+import (
+	"os"
+	"strconv"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// NewDefaultRegistry monta o registry de algoritmos usado pela aplicação:
+// argon2id como default (gera novos hashes) e bcrypt/legado aceitos apenas
+// para validar e re-hashear contas antigas no próximo login. Os parâmetros
+// do argon2id podem ser ajustados via env vars:
+//
+//	PASSWORD_ARGON2_MEMORY_KB   (default 65536, ou seja 64 MiB)
+//	PASSWORD_ARGON2_ITERATIONS  (default 3)
+//	PASSWORD_ARGON2_PARALLELISM (default 2)
+func NewDefaultRegistry() Password {
+	params := DefaultArgon2idParams()
+
+	if v, ok := envUint32("PASSWORD_ARGON2_MEMORY_KB"); ok {
+		params.MemoryKiB = v
+	}
+	if v, ok := envUint32("PASSWORD_ARGON2_ITERATIONS"); ok {
+		params.Iterations = v
+	}
+	if v, ok := envUint32("PASSWORD_ARGON2_PARALLELISM"); ok {
+		params.Parallelism = uint8(v)
+	}
+
+	return NewRegistry(
+		NewArgon2idHasher(params),
+		NewBcryptHasher(bcrypt.DefaultCost),
+		NewLegacyHasher(),
+	)
+}
+
+func envUint32(key string) (uint32, bool) {
+	v := os.Getenv(key)
+	if v == "" {
+		return 0, false
+	}
+
+	n, err := strconv.ParseUint(v, 10, 32)
+	if err != nil {
+		return 0, false
+	}
+
+	return uint32(n), true
+}
@@ -0,0 +1,64 @@
+package password
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const legacyPrefix = "$legacy$"
+
+// EncodeLegacy monta, a partir do hash e do salt armazenados no antigo
+// esquema de duas colunas (secret_hash/secret_salt), o encoded hash no
+// formato que legacyHasher entende. Usado na leitura de contas criadas antes
+// da introdução do hashing versionado, para que o salt, antes solto em uma
+// coluna própria, passe a viajar embutido no hash.
+func EncodeLegacy(hash, salt string) string {
+	return fmt.Sprintf("%s%s$%s", legacyPrefix, salt, hash)
+}
+
+type legacyHasher struct{}
+
+// NewLegacyHasher cria um Hasher que só sabe validar o esquema antigo
+// (sha256(secret+salt)); nunca deve ser usado para gerar novos hashes
+func NewLegacyHasher() Hasher {
+	return &legacyHasher{}
+}
+
+func (h *legacyHasher) Prefix() string {
+	return legacyPrefix
+}
+
+func (h *legacyHasher) Hash(secret string) (string, error) {
+	return "", errors.New("password: o algoritmo legado não deve ser usado para gerar novos hashes")
+}
+
+func (h *legacyHasher) Verify(encoded, secret string) (bool, error) {
+	salt, hash, err := decodeLegacy(encoded)
+	if err != nil {
+		return false, err
+	}
+
+	sum := sha256.Sum256([]byte(secret + salt))
+	candidate := hex.EncodeToString(sum[:])
+
+	return subtle.ConstantTimeCompare([]byte(candidate), []byte(hash)) == 1, nil
+}
+
+// NeedsUpgrade é sempre true: toda conta no esquema legado deve ser migrada
+// para o algoritmo default assim que autenticar com sucesso
+func (h *legacyHasher) NeedsUpgrade(encoded string) bool {
+	return true
+}
+
+func decodeLegacy(encoded string) (salt, hash string, err error) {
+	rest := strings.TrimPrefix(encoded, legacyPrefix)
+	parts := strings.SplitN(rest, "$", 2)
+	if len(parts) != 2 {
+		return "", "", fmt.Errorf("password: encoded hash legado inválido")
+	}
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,77 @@
+package password
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Hasher implementa um único algoritmo de hashing de senha, identificado pelo
+// prefixo PHC-style do hash que ele produz (ex: "$argon2id$", "$2", "$legacy$")
+type Hasher interface {
+	// Prefix identifica o algoritmo a partir do encoded hash
+	Prefix() string
+	// Hash gera um novo encoded hash para secret
+	Hash(secret string) (string, error)
+	// Verify confere se secret corresponde ao encoded hash
+	Verify(encoded, secret string) (bool, error)
+	// NeedsUpgrade reports se encoded foi gerado com parâmetros mais fracos
+	// que os atualmente configurados para este algoritmo
+	NeedsUpgrade(encoded string) bool
+}
+
+// Password abstrai o algoritmo utilizado para gerar e validar o hash da senha.
+// É um registry de Hasher: o algoritmo usado para Verify/NeedsUpgrade é
+// escolhido pelo prefixo do encoded hash, enquanto Hash sempre usa o
+// algoritmo default configurado.
+type Password interface {
+	Hash(secret string) (string, error)
+	Verify(encoded, secret string) (bool, error)
+	NeedsUpgrade(encoded string) bool
+}
+
+type registry struct {
+	def     Hasher
+	hashers []Hasher
+}
+
+// NewRegistry cria um registry de algoritmos de hash de senha. def é o
+// algoritmo usado para gerar novos hashes; others são algoritmos aceitos
+// apenas para Verify/NeedsUpgrade de hashes já existentes (ex: legado).
+func NewRegistry(def Hasher, others ...Hasher) Password {
+	return &registry{
+		def:     def,
+		hashers: append([]Hasher{def}, others...),
+	}
+}
+
+func (r *registry) Hash(secret string) (string, error) {
+	return r.def.Hash(secret)
+}
+
+func (r *registry) Verify(encoded, secret string) (bool, error) {
+	h, err := r.find(encoded)
+	if err != nil {
+		return false, err
+	}
+	return h.Verify(encoded, secret)
+}
+
+func (r *registry) NeedsUpgrade(encoded string) bool {
+	h, err := r.find(encoded)
+	if err != nil {
+		return true
+	}
+	if h.Prefix() != r.def.Prefix() {
+		return true
+	}
+	return h.NeedsUpgrade(encoded)
+}
+
+func (r *registry) find(encoded string) (Hasher, error) {
+	for _, h := range r.hashers {
+		if strings.HasPrefix(encoded, h.Prefix()) {
+			return h, nil
+		}
+	}
+	return nil, fmt.Errorf("password: algoritmo de hash desconhecido para o encoded hash informado")
+}